@@ -1,8 +1,11 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-06-01/policy"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -14,27 +17,34 @@ func dataSourceArmPolicyDefinition() *schema.Resource {
 		Read: dataSourceArmPolicyDefinitionRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validate.NoEmptyStrings,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"display_name"},
 			},
 
-			"policy_type": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"display_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"name"},
 			},
 
-			"mode": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
 			},
 
-			"management_group_id": {
+			"policy_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
-			"display_name": {
+			"mode": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -67,53 +77,359 @@ func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{})
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
+	displayName := d.Get("display_name").(string)
+	managementGroupID := d.Get("management_group_id").(string)
 
-	resp, err := client.Get(ctx, name)
-	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Error: Policy Definition %q was not found", name)
+	if name == "" && displayName == "" {
+		return fmt.Errorf("one of `name` or `display_name` must be specified")
+	}
+
+	var resp policy.Definition
+	if displayName != "" {
+		var err error
+		resp, err = getPolicyDefinitionByDisplayName(ctx, client, displayName, managementGroupID)
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if managementGroupID != "" {
+			resp, err = client.GetAtManagementGroup(ctx, name, managementGroupID)
+		} else {
+			resp, err = client.Get(ctx, name)
+		}
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Policy Definition %q was not found", name)
+			}
+			return err
 		}
-		return err
 	}
 
 	d.SetId(*resp.ID)
 
+	d.Set("name", resp.Name)
+
 	if props := resp.DefinitionProperties; props != nil {
 		d.Set("policy_type", props.PolicyType)
 		d.Set("mode", props.Mode)
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
+		d.Set("management_group_id", parseManagementGroupIDFromPolicyID(*resp.ID))
 
-		if policyRule := props.PolicyRule; policyRule != nil {
-			policyRuleVal := policyRule.(map[string]interface{})
-			policyRuleStr, err := structure.FlattenJsonToString(policyRuleVal)
-			if err != nil {
-				return fmt.Errorf("unable to flatten JSON for `policy_rule`: %s", err)
-			}
-
+		if policyRuleStr, err := flattenPolicyJSONField("policy_rule", props.PolicyRule); err != nil {
+			return err
+		} else if policyRuleStr != "" {
 			d.Set("policy_rule", policyRuleStr)
 		}
 
-		if metadata := props.Metadata; metadata != nil {
-			metadataVal := metadata.(map[string]interface{})
-			metadataStr, err := structure.FlattenJsonToString(metadataVal)
-			if err != nil {
-				return fmt.Errorf("unable to flatten JSON for `metadata`: %s", err)
-			}
-
+		if metadataStr, err := flattenPolicyJSONField("metadata", props.Metadata); err != nil {
+			return err
+		} else if metadataStr != "" {
 			d.Set("metadata", metadataStr)
 		}
 
-		if parameters := props.Parameters; parameters != nil {
-			paramsVal := props.Parameters.(map[string]interface{})
-			parametersStr, err := structure.FlattenJsonToString(paramsVal)
-			if err != nil {
-				return fmt.Errorf("unable to flatten JSON for `parameters`: %s", err)
+		if parametersStr, err := flattenPolicyJSONField("parameters", props.Parameters); err != nil {
+			return err
+		} else if parametersStr != "" {
+			d.Set("parameters", parametersStr)
+		}
+	}
+
+	return nil
+}
+
+// flattenPolicyJSONField flattens a policy field that the SDK models as an untyped `interface{}`
+// JSON blob (policy_rule, metadata, parameters, ...) back down to a JSON string, as used by both
+// data.azurerm_policy_definition and data.azurerm_policy_set_definition.
+func flattenPolicyJSONField(fieldName string, value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	result, err := structure.FlattenJsonToString(valueMap)
+	if err != nil {
+		return "", fmt.Errorf("unable to flatten JSON for `%s`: %s", fieldName, err)
+	}
+
+	return result, nil
+}
+
+func dataSourceArmPolicySetDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPolicySetDefinitionRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"display_name"},
+			},
+
+			"display_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"name"},
+			},
+
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"policy_definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_definition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"reference_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"parameter_values": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"group_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policySetDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	displayName := d.Get("display_name").(string)
+	managementGroupID := d.Get("management_group_id").(string)
+
+	if name == "" && displayName == "" {
+		return fmt.Errorf("one of `name` or `display_name` must be specified")
+	}
+
+	var resp policy.SetDefinition
+	if displayName != "" {
+		var err error
+		resp, err = getPolicySetDefinitionByDisplayName(ctx, client, displayName, managementGroupID)
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		switch {
+		case managementGroupID != "":
+			resp, err = client.GetAtManagementGroup(ctx, name, managementGroupID)
+		default:
+			resp, err = client.Get(ctx, name)
+			if err != nil && utils.ResponseWasNotFound(resp.Response) {
+				resp, err = client.GetBuiltIn(ctx, name)
 			}
+		}
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: Policy Set Definition %q was not found", name)
+			}
+			return err
+		}
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+
+	if props := resp.SetDefinitionProperties; props != nil {
+		d.Set("policy_type", props.PolicyType)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("management_group_id", parseManagementGroupIDFromPolicyID(*resp.ID))
 
+		if metadataStr, err := flattenPolicyJSONField("metadata", props.Metadata); err != nil {
+			return err
+		} else if metadataStr != "" {
+			d.Set("metadata", metadataStr)
+		}
+
+		if parametersStr, err := flattenPolicyJSONField("parameters", props.Parameters); err != nil {
+			return err
+		} else if parametersStr != "" {
 			d.Set("parameters", parametersStr)
 		}
+
+		if err := d.Set("policy_definitions", flattenAzureRMPolicySetDefinitionPolicyDefinitions(props.PolicyDefinitions)); err != nil {
+			return fmt.Errorf("Error flattening `policy_definitions`: %s", err)
+		}
 	}
 
 	return nil
 }
+
+func flattenAzureRMPolicySetDefinitionPolicyDefinitions(input *[]policy.DefinitionReference) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make([]interface{}, 0)
+	for _, v := range *input {
+		item := make(map[string]interface{})
+
+		if v.PolicyDefinitionID != nil {
+			item["policy_definition_id"] = *v.PolicyDefinitionID
+		}
+
+		if v.PolicyDefinitionReferenceID != nil {
+			item["reference_id"] = *v.PolicyDefinitionReferenceID
+		}
+
+		if v.Parameters != nil {
+			parameterValues := make(map[string]interface{})
+			for k, val := range v.Parameters {
+				if val != nil {
+					parameterValues[k] = val.Value
+				}
+			}
+
+			if parametersStr, err := flattenPolicyJSONField("parameter_values", parameterValues); err == nil {
+				item["parameter_values"] = parametersStr
+			}
+		}
+
+		if v.GroupNames != nil {
+			item["group_names"] = *v.GroupNames
+		} else {
+			item["group_names"] = make([]interface{}, 0)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func getPolicySetDefinitionByDisplayName(ctx context.Context, client policy.SetDefinitionsClient, displayName string, managementGroupID string) (policy.SetDefinition, error) {
+	var policySetDefinitions policy.SetDefinitionListResultIterator
+	var err error
+
+	if managementGroupID != "" {
+		policySetDefinitions, err = client.ListByManagementGroupComplete(ctx, managementGroupID, "")
+	} else {
+		policySetDefinitions, err = client.ListComplete(ctx, "")
+	}
+	if err != nil {
+		return policy.SetDefinition{}, fmt.Errorf("Error loading Policy Set Definition List: %+v", err)
+	}
+
+	var results []policy.SetDefinition
+	for policySetDefinitions.NotDone() {
+		def := policySetDefinitions.Value()
+		if def.SetDefinitionProperties != nil && def.DisplayName != nil && *def.DisplayName == displayName {
+			results = append(results, def)
+		}
+
+		if err := policySetDefinitions.NextWithContext(ctx); err != nil {
+			return policy.SetDefinition{}, fmt.Errorf("Error loading Policy Set Definition List: %+v", err)
+		}
+	}
+
+	if len(results) == 0 {
+		return policy.SetDefinition{}, fmt.Errorf("Error: no Policy Set Definition found matching display_name %q", displayName)
+	}
+
+	if len(results) > 1 {
+		return policy.SetDefinition{}, fmt.Errorf("Error: found %d Policy Set Definitions matching display_name %q - try using `name` instead", len(results), displayName)
+	}
+
+	return results[0], nil
+}
+
+var managementGroupIDFromResourceIDRegex = regexp.MustCompile(`(?i)/providers/Microsoft\.Management/managementGroups/([^/]+)`)
+
+// parseManagementGroupIDFromPolicyID extracts the management group ID from a management-group
+// scoped policy (set) definition resource ID, returning "" for subscription-scoped/built-in ones.
+func parseManagementGroupIDFromPolicyID(id string) string {
+	matches := managementGroupIDFromResourceIDRegex.FindStringSubmatch(id)
+	if len(matches) < 2 {
+		return ""
+	}
+
+	return matches[1]
+}
+
+func getPolicyDefinitionByDisplayName(ctx context.Context, client policy.DefinitionsClient, displayName string, managementGroupID string) (policy.Definition, error) {
+	var policyDefinitions policy.DefinitionListResultIterator
+	var err error
+
+	if managementGroupID != "" {
+		policyDefinitions, err = client.ListByManagementGroupComplete(ctx, managementGroupID, "")
+	} else {
+		policyDefinitions, err = client.ListComplete(ctx, "")
+	}
+	if err != nil {
+		return policy.Definition{}, fmt.Errorf("Error loading Policy Definition List: %+v", err)
+	}
+
+	var results []policy.Definition
+	for policyDefinitions.NotDone() {
+		def := policyDefinitions.Value()
+		if def.DefinitionProperties != nil && def.DisplayName != nil && *def.DisplayName == displayName {
+			results = append(results, def)
+		}
+
+		if err := policyDefinitions.NextWithContext(ctx); err != nil {
+			return policy.Definition{}, fmt.Errorf("Error loading Policy Definition List: %+v", err)
+		}
+	}
+
+	if len(results) == 0 {
+		return policy.Definition{}, fmt.Errorf("Error: no Policy Definition found matching display_name %q", displayName)
+	}
+
+	if len(results) > 1 {
+		return policy.Definition{}, fmt.Errorf("Error: found %d Policy Definitions matching display_name %q - try using `name` instead", len(results), displayName)
+	}
+
+	return results[0], nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -51,7 +52,59 @@ func resourceArmDataFactoryIntegrationRuntime() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"SelfHosted", "Managed"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"SelfHosted", "Managed", "SelfHostedLinked"}, false),
+			},
+
+			"linked_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"auth_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"linked_integration_runtimes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subscription_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"data_factory_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"data_factory_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 
 			"description": {
@@ -108,24 +161,101 @@ func resourceArmDataFactoryIntegrationRuntime() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 
-						"catalog_info": ,
-							"CatalogServerEndpoint"
-							"CatalogAdminUserName"
-							"CatalogAdminPassword"
-							"CatalogPricingTier"
-						"custom_setup_script_properties": ,
-							"BlobContainerURI"
-							"SasToken"
-						"data_proxy_properties": ,
-							"ConnectVia"
-								"ReferenceName"
-								"***Type"
-							"StagingLinkedService"
-								"ReferenceName"
-								"***Type"
-							"Path"
-						"edition": ,
-						"licenseType": {"BasePrice", "LicenseIncluded"},
+						"catalog_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server_endpoint": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"administrator_login": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"administrator_password": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"pricing_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium", "PremiumRS"}, false),
+									},
+								},
+							},
+						},
+
+						"custom_setup_script": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"blob_container_uri": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"sas_token": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"data_proxy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"self_hosted_integration_runtime_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"staging_storage_linked_service_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"edition": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(datafactory.IntegrationRuntimeEditionStandard),
+							ValidateFunc: validation.StringInSlice([]string{string(datafactory.IntegrationRuntimeEditionStandard), string(datafactory.IntegrationRuntimeEditionEnterprise)}, false),
+						},
+
+						"license_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(datafactory.IntegrationRuntimeLicenseTypeLicenseIncluded),
+							ValidateFunc: validation.StringInSlice([]string{string(datafactory.IntegrationRuntimeLicenseTypeBasePrice), string(datafactory.IntegrationRuntimeLicenseTypeLicenseIncluded)}, false),
+						},
 					},
 				},
 			},
@@ -180,14 +310,58 @@ func resourceArmDataFactoryIntegrationRuntimeCreateOrUpdate(d *schema.ResourceDa
 		if err != nil {
 			return fmt.Errorf("Error parsing integration runtime compute properties: %s", err)
 		}
+		managedIntegrationRuntimeSsisProperties, err := expandAzureDataFactoryIntegrationRuntimeSsisProperties(d)
+		if err != nil {
+			return fmt.Errorf("Error parsing integration runtime ssis properties: %s", err)
+		}
 		managedIntegrationRuntimeProperties := &datafactory.ManagedIntegrationRuntimeTypeProperties{
 			ComputeProperties: managedIntegrationRuntimeComputeProperties,
+			SsisProperties:    managedIntegrationRuntimeSsisProperties,
 		}
 		integrationRuntime = &datafactory.ManagedIntegrationRuntime{
 			ManagedIntegrationRuntimeTypeProperties: managedIntegrationRuntimeProperties,
 			Description:                             &description,
 			Type:                                    datafactory.TypeManaged,
 		}
+
+	case "SelfHostedLinked":
+		linkedInfoRaw := d.Get("linked_info").([]interface{})
+		if len(linkedInfoRaw) == 0 {
+			return fmt.Errorf("`linked_info` must be set when `type` is `SelfHostedLinked`")
+		}
+		linkedInfoConfig := linkedInfoRaw[0].(map[string]interface{})
+
+		resourceID := linkedInfoConfig["resource_id"].(string)
+		authKey := linkedInfoConfig["auth_key"].(string)
+
+		var linkedInfo datafactory.BasicLinkedIntegrationRuntimeType
+		switch {
+		case resourceID != "":
+			linkedInfo = &datafactory.LinkedIntegrationRuntimeRbacAuthorization{
+				ResourceID:        &resourceID,
+				AuthorizationType: datafactory.AuthorizationTypeRBAC,
+			}
+
+			if err := grantAzureDataFactorySelfHostedIntegrationRuntimeAccess(d, meta, resourceID); err != nil {
+				return fmt.Errorf("Error granting access to the linked Self-Hosted Integration Runtime %q: %+v", resourceID, err)
+			}
+		case authKey != "":
+			linkedInfo = &datafactory.LinkedIntegrationRuntimeKeyAuthorization{
+				Key: &datafactory.SecureString{
+					Value: &authKey,
+					Type:  datafactory.TypeSecureString,
+				},
+				AuthorizationType: datafactory.AuthorizationTypeKey,
+			}
+		default:
+			return fmt.Errorf("one of `resource_id` or `auth_key` must be set in `linked_info`")
+		}
+
+		integrationRuntime = &datafactory.SelfHostedIntegrationRuntime{
+			Description: &description,
+			Type:        datafactory.TypeSelfHosted,
+			LinkedInfo:  linkedInfo,
+		}
 	}
 
 	config := datafactory.IntegrationRuntimeResource{
@@ -243,6 +417,14 @@ func resourceArmDataFactoryIntegrationRuntimeRead(d *schema.ResourceData, meta i
 
 		switch props.Type {
 		case datafactory.TypeSelfHosted:
+			selfHosted, _ := resp.Properties.AsSelfHostedIntegrationRuntime()
+			if selfHosted != nil && selfHosted.LinkedInfo != nil {
+				// this is a linked self-hosted IR sharing another self-hosted IR - auth keys and
+				// compute properties don't apply, `type` is surfaced as `SelfHostedLinked`
+				d.Set("type", "SelfHostedLinked")
+				break
+			}
+
 			keys, err := client.ListAuthKeys(ctx, id.ResourceGroup, dataFactoryName, name)
 			if err != nil {
 				return err
@@ -250,11 +432,23 @@ func resourceArmDataFactoryIntegrationRuntimeRead(d *schema.ResourceData, meta i
 			d.Set("auth_key_1", keys.AuthKey1)
 			d.Set("auth_key_2", keys.AuthKey2)
 
+			status, err := client.GetStatus(ctx, id.ResourceGroup, dataFactoryName, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving status of Data Factory Integration Runtime %q: %+v", name, err)
+			}
+			if err := d.Set("linked_integration_runtimes", flattenAzureDataFactoryIntegrationRuntimeLinkedIntegrationRuntimes(status)); err != nil {
+				return fmt.Errorf("Error flattening `linked_integration_runtimes`: %+v", err)
+			}
+
 		case datafactory.TypeManaged:
 			managedIntegrationRuntime, _ := resp.Properties.AsManagedIntegrationRuntime()
-			if err := d.Set("compute_properties", flattenAzureDataFactoryIntegrationRuntimeComputeProperties(managedIntegrationRuntime.ManagedIntegrationRuntimeTypeProperties.ComputeProperties)); err != nil {
+			typeProperties := managedIntegrationRuntime.ManagedIntegrationRuntimeTypeProperties
+			if err := d.Set("compute_properties", flattenAzureDataFactoryIntegrationRuntimeComputeProperties(typeProperties.ComputeProperties)); err != nil {
 				return fmt.Errorf("Error flattening `compute_properties`: %+v", err)
 			}
+			if err := d.Set("ssis_properties", flattenAzureDataFactoryIntegrationRuntimeSsisProperties(d, typeProperties.SsisProperties)); err != nil {
+				return fmt.Errorf("Error flattening `ssis_properties`: %+v", err)
+			}
 		}
 	}
 
@@ -273,6 +467,17 @@ func resourceArmDataFactoryIntegrationRuntimeDelete(d *schema.ResourceData, meta
 	name := id.Path["integrationruntimes"]
 	resourceGroupName := id.ResourceGroup
 
+	if d.Get("type").(string) == "SelfHostedLinked" {
+		if linkedInfoRaw := d.Get("linked_info").([]interface{}); len(linkedInfoRaw) > 0 {
+			linkedInfoConfig := linkedInfoRaw[0].(map[string]interface{})
+			if resourceID := linkedInfoConfig["resource_id"].(string); resourceID != "" {
+				if err := revokeAzureDataFactorySelfHostedIntegrationRuntimeAccess(meta, resourceID, dataFactoryName); err != nil {
+					return fmt.Errorf("Error revoking access to the linked Self-Hosted Integration Runtime %q: %+v", resourceID, err)
+				}
+			}
+		}
+	}
+
 	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
 		return fmt.Errorf("Error deleting Data Factory Integration Runtime %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
 	}
@@ -349,3 +554,222 @@ func expandAzureDataFactoryIntegrationRuntimeComputeProperties(d *schema.Resourc
 
 	return integrationRuntimeComputeProperties, nil
 }
+
+func expandAzureDataFactoryIntegrationRuntimeSsisProperties(d *schema.ResourceData) (*datafactory.IntegrationRuntimeSsisProperties, error) {
+	ssisPropertiesRaw := d.Get("ssis_properties").([]interface{})
+	if len(ssisPropertiesRaw) == 0 {
+		return nil, nil
+	}
+	config := ssisPropertiesRaw[0].(map[string]interface{})
+
+	ssisProperties := &datafactory.IntegrationRuntimeSsisProperties{
+		Edition:     datafactory.IntegrationRuntimeEdition(config["edition"].(string)),
+		LicenseType: datafactory.IntegrationRuntimeLicenseType(config["license_type"].(string)),
+	}
+
+	if catalogInfoRaw := config["catalog_info"].([]interface{}); len(catalogInfoRaw) > 0 {
+		catalogInfo := catalogInfoRaw[0].(map[string]interface{})
+
+		serverEndpoint := catalogInfo["server_endpoint"].(string)
+		catalog := &datafactory.IntegrationRuntimeSsisCatalogInfo{
+			CatalogServerEndpoint: &serverEndpoint,
+			CatalogPricingTier:    datafactory.IntegrationRuntimeSsisCatalogPricingTier(catalogInfo["pricing_tier"].(string)),
+		}
+
+		if v := catalogInfo["administrator_login"].(string); v != "" {
+			catalog.CatalogAdminUserName = &v
+		}
+
+		if v := catalogInfo["administrator_password"].(string); v != "" {
+			catalog.CatalogAdminPassword = &datafactory.SecureString{
+				Value: &v,
+				Type:  datafactory.TypeSecureString,
+			}
+		}
+
+		ssisProperties.CatalogInfo = catalog
+	}
+
+	if customSetupScriptRaw := config["custom_setup_script"].([]interface{}); len(customSetupScriptRaw) > 0 {
+		customSetupScript := customSetupScriptRaw[0].(map[string]interface{})
+
+		blobContainerURI := customSetupScript["blob_container_uri"].(string)
+		sasToken := customSetupScript["sas_token"].(string)
+
+		ssisProperties.CustomSetupScriptProperties = &datafactory.IntegrationRuntimeCustomSetupScriptProperties{
+			BlobContainerURI: &blobContainerURI,
+			SasToken: &datafactory.SecureString{
+				Value: &sasToken,
+				Type:  datafactory.TypeSecureString,
+			},
+		}
+	}
+
+	if dataProxyRaw := config["data_proxy"].([]interface{}); len(dataProxyRaw) > 0 {
+		dataProxy := dataProxyRaw[0].(map[string]interface{})
+
+		selfHostedName := dataProxy["self_hosted_integration_runtime_name"].(string)
+		stagingLinkedServiceName := dataProxy["staging_storage_linked_service_name"].(string)
+
+		dataProxyProperties := &datafactory.IntegrationRuntimeDataProxyProperties{
+			ConnectVia: &datafactory.EntityReference{
+				Type:          datafactory.IntegrationRuntimeReference,
+				ReferenceName: &selfHostedName,
+			},
+			StagingLinkedService: &datafactory.EntityReference{
+				Type:          datafactory.LinkedServiceReference,
+				ReferenceName: &stagingLinkedServiceName,
+			},
+		}
+
+		if path := dataProxy["path"].(string); path != "" {
+			dataProxyProperties.Path = &path
+		}
+
+		ssisProperties.DataProxyProperties = dataProxyProperties
+	}
+
+	return ssisProperties, nil
+}
+
+func flattenAzureDataFactoryIntegrationRuntimeSsisProperties(d *schema.ResourceData, properties *datafactory.IntegrationRuntimeSsisProperties) []interface{} {
+	if properties == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := map[string]interface{}{
+		"edition":      string(properties.Edition),
+		"license_type": string(properties.LicenseType),
+	}
+
+	if catalog := properties.CatalogInfo; catalog != nil {
+		catalogInfo := map[string]interface{}{
+			"pricing_tier": string(catalog.CatalogPricingTier),
+		}
+		if catalog.CatalogServerEndpoint != nil {
+			catalogInfo["server_endpoint"] = *catalog.CatalogServerEndpoint
+		}
+		if catalog.CatalogAdminUserName != nil {
+			catalogInfo["administrator_login"] = *catalog.CatalogAdminUserName
+		}
+		// the admin password isn't returned from the API, so we preserve whatever's in config
+		catalogInfo["administrator_password"] = d.Get("ssis_properties.0.catalog_info.0.administrator_password").(string)
+
+		result["catalog_info"] = []interface{}{catalogInfo}
+	}
+
+	if script := properties.CustomSetupScriptProperties; script != nil {
+		customSetupScript := map[string]interface{}{
+			// the SAS token isn't returned from the API, so we preserve whatever's in config
+			"sas_token": d.Get("ssis_properties.0.custom_setup_script.0.sas_token").(string),
+		}
+		if script.BlobContainerURI != nil {
+			customSetupScript["blob_container_uri"] = *script.BlobContainerURI
+		}
+
+		result["custom_setup_script"] = []interface{}{customSetupScript}
+	}
+
+	if proxy := properties.DataProxyProperties; proxy != nil {
+		dataProxy := map[string]interface{}{}
+		if proxy.ConnectVia != nil && proxy.ConnectVia.ReferenceName != nil {
+			dataProxy["self_hosted_integration_runtime_name"] = *proxy.ConnectVia.ReferenceName
+		}
+		if proxy.StagingLinkedService != nil && proxy.StagingLinkedService.ReferenceName != nil {
+			dataProxy["staging_storage_linked_service_name"] = *proxy.StagingLinkedService.ReferenceName
+		}
+		if proxy.Path != nil {
+			dataProxy["path"] = *proxy.Path
+		}
+
+		result["data_proxy"] = []interface{}{dataProxy}
+	}
+
+	return []interface{}{result}
+}
+
+// grantAzureDataFactorySelfHostedIntegrationRuntimeAccess grants the linked integration runtime
+// being created access to the source self-hosted integration runtime identified by resourceID.
+func grantAzureDataFactorySelfHostedIntegrationRuntimeAccess(d *schema.ResourceData, meta interface{}, resourceID string) error {
+	client := meta.(*ArmClient).dataFactory.IntegrationRuntimesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	sourceID, err := azure.ParseAzureResourceID(resourceID)
+	if err != nil {
+		return fmt.Errorf("Error parsing `linked_info.resource_id` %q: %s", resourceID, err)
+	}
+	sourceDataFactoryName := sourceID.Path["factories"]
+	sourceIntegrationRuntimeName := sourceID.Path["integrationruntimes"]
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	subscriptionID := meta.(*ArmClient).subscriptionId
+
+	request := datafactory.CreateLinkedIntegrationRuntimeRequest{
+		Name:            &name,
+		SubscriptionID:  &subscriptionID,
+		DataFactoryName: &dataFactoryName,
+	}
+
+	if _, err := client.CreateLinkedIntegrationRuntime(ctx, sourceID.ResourceGroup, sourceDataFactoryName, sourceIntegrationRuntimeName, request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// revokeAzureDataFactorySelfHostedIntegrationRuntimeAccess revokes the access previously granted
+// to a linked integration runtime from the source self-hosted integration runtime identified by
+// resourceID, so the source's `linked_integration_runtimes` list doesn't go stale once the linked
+// resource is destroyed.
+func revokeAzureDataFactorySelfHostedIntegrationRuntimeAccess(meta interface{}, resourceID string, dataFactoryName string) error {
+	client := meta.(*ArmClient).dataFactory.IntegrationRuntimesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	sourceID, err := azure.ParseAzureResourceID(resourceID)
+	if err != nil {
+		return fmt.Errorf("Error parsing `linked_info.resource_id` %q: %s", resourceID, err)
+	}
+	sourceDataFactoryName := sourceID.Path["factories"]
+	sourceIntegrationRuntimeName := sourceID.Path["integrationruntimes"]
+
+	if _, err := client.RemoveLinks(ctx, sourceID.ResourceGroup, sourceDataFactoryName, sourceIntegrationRuntimeName, datafactory.LinkedIntegrationRuntimeRequest{
+		LinkedFactoryName: &dataFactoryName,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenAzureDataFactoryIntegrationRuntimeLinkedIntegrationRuntimes(status datafactory.IntegrationRuntimeStatusResponse) []interface{} {
+	selfHostedStatus, _ := status.Properties.AsSelfHostedIntegrationRuntimeStatus()
+	if selfHostedStatus == nil || selfHostedStatus.LinkedInfo == nil {
+		return make([]interface{}, 0)
+	}
+
+	linked, ok := selfHostedStatus.LinkedInfo.(*[]datafactory.LinkedIntegrationRuntime)
+	if !ok || linked == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make([]interface{}, 0)
+	for _, item := range *linked {
+		v := make(map[string]interface{})
+		if item.Name != nil {
+			v["name"] = *item.Name
+		}
+		if item.SubscriptionID != nil {
+			v["subscription_id"] = *item.SubscriptionID
+		}
+		if item.DataFactoryName != nil {
+			v["data_factory_name"] = *item.DataFactoryName
+		}
+		if item.DataFactoryLocation != nil {
+			v["data_factory_location"] = *item.DataFactoryLocation
+		}
+		result = append(result, v)
+	}
+
+	return result
+}
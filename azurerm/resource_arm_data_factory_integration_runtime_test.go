@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataFactoryIntegrationRuntimeManaged_ssisCatalog(t *testing.T) {
+	resourceName := "azurerm_data_factory_integration_runtime.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+	config := testAccAzureRMDataFactoryIntegrationRuntimeManaged_ssisCatalog(ri, rs, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryIntegrationRuntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryIntegrationRuntimeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Managed"),
+					resource.TestCheckResourceAttr(resourceName, "ssis_properties.0.edition", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "ssis_properties.0.license_type", "LicenseIncluded"),
+					resource.TestCheckResourceAttr(resourceName, "ssis_properties.0.catalog_info.0.pricing_tier", "Basic"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ssis_properties.0.catalog_info.0.administrator_password",
+				},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryIntegrationRuntimeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dataFactory.IntegrationRuntimesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Data Factory Integration Runtime %q (Data Factory %q / Resource Group %q) does not exist", name, dataFactoryName, resourceGroup)
+			}
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryIntegrationRuntimeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dataFactory.IntegrationRuntimesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_integration_runtime" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Data Factory Integration Runtime %q (Data Factory %q / Resource Group %q) still exists", name, dataFactoryName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataFactoryIntegrationRuntimeManaged_ssisCatalog(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsql%s"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "ssisadmin"
+  administrator_login_password = "ExamplePassword1!"
+}
+
+resource "azurerm_data_factory_integration_runtime" "test" {
+  name                = "acctestir%s"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Managed"
+
+  compute_properties {
+    location            = azurerm_resource_group.test.location
+    node_size           = "Standard_D8_v3"
+    node_count          = 2
+    max_node_executions = 2
+  }
+
+  ssis_properties {
+    edition      = "Standard"
+    license_type = "LicenseIncluded"
+
+    catalog_info {
+      server_endpoint        = "${azurerm_sql_server.test.name}.database.windows.net"
+      administrator_login    = azurerm_sql_server.test.administrator_login
+      administrator_password = azurerm_sql_server.test.administrator_login_password
+      pricing_tier           = "Basic"
+    }
+  }
+}
+`, rInt, location, rString, rString, rString)
+}
@@ -17,6 +17,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// Out of scope for this checkout: request chunk0-5 asks for a nested `extension` block on
+// azurerm_virtual_machine and azurerm_virtual_machine_scale_set (mirroring this standalone
+// resource, with a collision warning when the same name is declared both ways). Neither
+// resource_arm_virtual_machine.go nor resource_arm_virtual_machine_scale_set.go exists in this
+// tree, so there's nowhere to add the block or the warning; blocked until those files land.
 func resourceArmVirtualMachineExtensions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualMachineExtensionsCreateUpdate,
@@ -116,6 +121,72 @@ func resourceArmVirtualMachineExtensions() *schema.Resource {
 			},
 
 			"tags": tags.Schema(),
+
+			"instance_view": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type_handler_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"statuses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     resourceArmVirtualMachineExtensionStatusResourceSchema(),
+						},
+
+						"substatuses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     resourceArmVirtualMachineExtensionStatusResourceSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineExtensionStatusResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -208,7 +279,7 @@ func resourceArmVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, met
 		return err
 	}
 
-	read, err := client.Get(ctx, resGroup, vmName, name, "")
+	read, err := client.Get(ctx, resGroup, vmName, name, "instanceView")
 	if err != nil {
 		return err
 	}
@@ -219,9 +290,37 @@ func resourceArmVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, met
 
 	d.SetId(*read.ID)
 
+	// the extension exists in Azure by this point, so its ID must be persisted to state above
+	// before returning any error - otherwise Terraform never learns about it and a subsequent
+	// apply/destroy can't find it again.
+	if err := checkVirtualMachineExtensionInstanceViewForErrors(name, read.VirtualMachineExtensionProperties); err != nil {
+		return err
+	}
+
 	return resourceArmVirtualMachineExtensionsRead(d, meta)
 }
 
+// checkVirtualMachineExtensionInstanceViewForErrors surfaces a failure that happened inside the
+// extension (e.g. a failing CustomScriptExtension) as a Terraform error, since the ARM call for
+// CreateOrUpdate can complete successfully even though the extension itself failed to apply.
+func checkVirtualMachineExtensionInstanceViewForErrors(name string, props *compute.VirtualMachineExtensionProperties) error {
+	if props == nil || props.InstanceView == nil || props.InstanceView.Substatuses == nil {
+		return nil
+	}
+
+	for _, status := range *props.InstanceView.Substatuses {
+		if status.Level == compute.StatusLevelTypesError {
+			message := ""
+			if status.Message != nil {
+				message = *status.Message
+			}
+			return fmt.Errorf("Virtual Machine Extension %q reported an error: %s", name, message)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMExtensionClient
 	ctx := meta.(*ArmClient).StopContext
@@ -234,7 +333,7 @@ func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interf
 	vmName := id.Path["virtualMachines"]
 	name := id.Path["extensions"]
 
-	resp, err := client.Get(ctx, resGroup, vmName, name, "")
+	resp, err := client.Get(ctx, resGroup, vmName, name, "instanceView")
 
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -268,11 +367,67 @@ func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interf
 			}
 			d.Set("settings", settingsJson)
 		}
+
+		if err := d.Set("instance_view", flattenAzureRmVirtualMachineExtensionInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("Error setting `instance_view`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+func flattenAzureRmVirtualMachineExtensionInstanceView(instanceView *compute.VirtualMachineExtensionInstanceView) []interface{} {
+	if instanceView == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+
+	if instanceView.Name != nil {
+		result["name"] = *instanceView.Name
+	}
+	if instanceView.Type != nil {
+		result["type"] = *instanceView.Type
+	}
+	if instanceView.TypeHandlerVersion != nil {
+		result["type_handler_version"] = *instanceView.TypeHandlerVersion
+	}
+	if instanceView.Statuses != nil {
+		result["statuses"] = flattenAzureRmVirtualMachineExtensionInstanceViewStatuses(*instanceView.Statuses)
+	}
+	if instanceView.Substatuses != nil {
+		result["substatuses"] = flattenAzureRmVirtualMachineExtensionInstanceViewStatuses(*instanceView.Substatuses)
+	}
+
+	return []interface{}{result}
+}
+
+func flattenAzureRmVirtualMachineExtensionInstanceViewStatuses(statuses []compute.InstanceViewStatus) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, status := range statuses {
+		v := make(map[string]interface{})
+
+		if status.Code != nil {
+			v["code"] = *status.Code
+		}
+		v["level"] = string(status.Level)
+		if status.DisplayStatus != nil {
+			v["display_status"] = *status.DisplayStatus
+		}
+		if status.Message != nil {
+			v["message"] = *status.Message
+		}
+		if status.Time != nil {
+			v["time"] = status.Time.String()
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
 func resourceArmVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMExtensionClient
 	ctx := meta.(*ArmClient).StopContext